@@ -22,10 +22,12 @@ func With(err error, keyvals ...interface{}) error {
 	}
 
 	var kvs []interface{}
+	var stack []uintptr
 
 	if c, ok := err.(*contextualError); ok {
 		err = c.err
 		kvs = c.keyvals
+		stack = c.stack
 	} else if c, ok := err.(ContextualError); ok {
 		kvs = c.Context()
 	}
@@ -41,6 +43,7 @@ func With(err error, keyvals ...interface{}) error {
 		// backing array is created if the slice must grow in With.
 		// Using the extra capacity without copying risks a data race.
 		keyvals: kvs[:len(kvs):len(kvs)],
+		stack:   stack,
 	}
 }
 
@@ -53,10 +56,12 @@ func WithPrefix(err error, keyvals ...interface{}) error {
 	}
 
 	var prevkvs []interface{}
+	var stack []uintptr
 
 	if c, ok := err.(*contextualError); ok {
 		err = c.err
 		prevkvs = c.keyvals
+		stack = c.stack
 	} else if c, ok := err.(ContextualError); ok {
 		prevkvs = c.Context()
 	}
@@ -78,15 +83,18 @@ func WithPrefix(err error, keyvals ...interface{}) error {
 	return &contextualError{
 		err:     err,
 		keyvals: kvs,
+		stack:   stack,
 	}
 }
 
 // contextualError is the ContextualError implementation returned by With.
 //
-// It wraps an error and a holds keyvals as the context.
+// It wraps an error and a holds keyvals as the context, plus an optional
+// stack trace captured by New, Errorf, Wrap or WithStack.
 type contextualError struct {
 	err     error
 	keyvals []interface{}
+	stack   []uintptr
 }
 
 // Error calls the underlying error and returns it's message.
@@ -97,4 +105,16 @@ func (e *contextualError) Error() string {
 // Context returns the appended keyvals.
 func (e *contextualError) Context() []interface{} {
 	return e.keyvals
-}
\ No newline at end of file
+}
+
+// Unwrap returns the wrapped error, letting errors.Is and errors.As from the
+// standard library traverse through chains built with With and WithPrefix.
+func (e *contextualError) Unwrap() error {
+	return e.err
+}
+
+// StackTrace returns the raw PC stack captured by New, Errorf, Wrap or
+// WithStack, or nil if none was captured.
+func (e *contextualError) StackTrace() []uintptr {
+	return e.stack
+}
@@ -0,0 +1,85 @@
+package errors_test
+
+import (
+	goerrors "errors"
+	"testing"
+
+	"github.com/goph/stdlib/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type customError struct {
+	msg string
+}
+
+func (e *customError) Error() string {
+	return e.msg
+}
+
+func TestWith_Unwrap(t *testing.T) {
+	sentinel := goerrors.New("sentinel")
+
+	err := errors.With(sentinel, "key", "value")
+
+	assert.Equal(t, sentinel, goerrors.Unwrap(err))
+}
+
+func TestWith_Is(t *testing.T) {
+	sentinel := goerrors.New("sentinel")
+
+	err := errors.With(sentinel, "key", "value")
+	err = errors.With(err, "another", "value")
+
+	assert.True(t, goerrors.Is(err, sentinel))
+}
+
+func TestWith_As(t *testing.T) {
+	original := &customError{msg: "custom"}
+
+	err := errors.With(error(original), "key", "value")
+
+	var target *customError
+
+	require := assert.New(t)
+	require.True(goerrors.As(err, &target))
+	require.Equal(original, target)
+}
+
+func TestWithPrefix_Is(t *testing.T) {
+	sentinel := goerrors.New("sentinel")
+
+	err := errors.WithPrefix(sentinel, "key", "value")
+	err = errors.WithPrefix(err, "another", "value")
+
+	assert.True(t, goerrors.Is(err, sentinel))
+}
+
+func TestWithPrefix_As(t *testing.T) {
+	original := &customError{msg: "custom"}
+
+	err := errors.WithPrefix(error(original), "key", "value")
+
+	var target *customError
+
+	assert.True(t, goerrors.As(err, &target))
+	assert.Equal(t, original, target)
+}
+
+func TestWithAndWithPrefix_MixedChain_Is(t *testing.T) {
+	sentinel := goerrors.New("sentinel")
+
+	err := errors.With(sentinel, "a", 1)
+	err = errors.WithPrefix(err, "b", 2)
+	err = errors.With(err, "c", 3)
+
+	assert.True(t, goerrors.Is(err, sentinel))
+}
+
+func TestWith_IsFalseForUnrelatedError(t *testing.T) {
+	sentinel := goerrors.New("sentinel")
+	other := goerrors.New("other")
+
+	err := errors.With(other, "key", "value")
+
+	assert.False(t, goerrors.Is(err, sentinel))
+}
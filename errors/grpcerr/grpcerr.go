@@ -0,0 +1,144 @@
+// Package grpcerr maps ContextualError chains onto gRPC status codes and
+// details, so a single error built with errors.With/errors.Wrap can surface
+// the right code at the RPC boundary while still supporting errors.Is and
+// errors.As for sentinel checks further up the call stack.
+package grpcerr
+
+import (
+	goerrors "errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/goph/stdlib/errors"
+)
+
+// codeCarrier is implemented by errors attached with WithCode.
+type codeCarrier interface {
+	GRPCCode() codes.Code
+}
+
+// detailsCarrier is implemented by errors attached with WithGRPCDetails.
+type detailsCarrier interface {
+	GRPCDetails() []proto.Message
+}
+
+// codeError attaches a gRPC status code to err as a typed carrier so
+// GRPCStatus can recover it. It does not go through errors.With: the code is
+// already conveyed by the status code itself, so folding it into the
+// metadata too would just duplicate it on the wire.
+type codeError struct {
+	error
+	code codes.Code
+}
+
+// WithCode attaches code to err. GRPCStatus walks the chain outer to inner
+// and keeps overwriting its result on every code it finds, so whichever code
+// sits deepest in the chain (closest to the original cause) is the one that
+// wins: a code already attached deeper inside err takes precedence over code.
+func WithCode(err error, code codes.Code) error {
+	return &codeError{
+		error: err,
+		code:  code,
+	}
+}
+
+func (e *codeError) Unwrap() error        { return e.error }
+func (e *codeError) GRPCCode() codes.Code { return e.code }
+
+// GRPCStatus implements the interface grpc-go's status.FromError looks for,
+// so a codeError can be passed directly across an RPC boundary.
+func (e *codeError) GRPCStatus() *status.Status {
+	return GRPCStatus(e)
+}
+
+// detailsError attaches gRPC detail messages to err, mirroring codeError: the
+// details are already conveyed as typed status details, so they are not
+// also folded into the metadata via errors.With.
+type detailsError struct {
+	error
+	details []proto.Message
+}
+
+// WithGRPCDetails attaches details to err.
+func WithGRPCDetails(err error, details ...proto.Message) error {
+	return &detailsError{
+		error:   err,
+		details: details,
+	}
+}
+
+func (e *detailsError) Unwrap() error                { return e.error }
+func (e *detailsError) GRPCDetails() []proto.Message { return e.details }
+func (e *detailsError) GRPCStatus() *status.Status   { return GRPCStatus(e) }
+
+// GRPCStatus converts err into a gRPC status. It walks the error chain for
+// the innermost attached code, falling back to codes.Unknown if none is
+// present, collects every attached detail message, and folds any
+// ContextualError keyvals found along the way into an errdetails.ErrorInfo
+// detail so they survive the RPC boundary.
+func GRPCStatus(err error) *status.Status {
+	if err == nil {
+		return status.New(codes.OK, "")
+	}
+
+	code := codes.Unknown
+	var details []proto.Message
+	metadata := map[string]string{}
+
+	for e := err; e != nil; e = goerrors.Unwrap(e) {
+		if c, ok := e.(codeCarrier); ok {
+			code = c.GRPCCode()
+		}
+
+		if d, ok := e.(detailsCarrier); ok {
+			details = append(details, d.GRPCDetails()...)
+		}
+
+		if ctxErr, ok := e.(errors.ContextualError); ok {
+			foldContext(metadata, ctxErr.Context())
+		}
+	}
+
+	st := status.New(code, err.Error())
+
+	if len(metadata) > 0 {
+		details = append(details, &errdetails.ErrorInfo{Metadata: metadata})
+	}
+
+	if len(details) == 0 {
+		return st
+	}
+
+	v1details := make([]protoadapt.MessageV1, len(details))
+	for i, d := range details {
+		v1details[i] = protoadapt.MessageV1Of(d)
+	}
+
+	if withDetails, derr := st.WithDetails(v1details...); derr == nil {
+		return withDetails
+	}
+
+	return st
+}
+
+// foldContext flattens keyvals pairs into metadata, stringifying both the
+// key and the value since errdetails.ErrorInfo.Metadata is map[string]string.
+func foldContext(metadata map[string]string, keyvals []interface{}) {
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		key, ok := keyvals[i].(string)
+		if !ok {
+			continue
+		}
+
+		if _, exists := metadata[key]; exists {
+			continue
+		}
+
+		metadata[key] = fmt.Sprintf("%v", keyvals[i+1])
+	}
+}
@@ -0,0 +1,90 @@
+package grpcerr_test
+
+import (
+	goerrors "errors"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/goph/stdlib/errors"
+	"github.com/goph/stdlib/errors/grpcerr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGRPCStatus_DefaultsToUnknown(t *testing.T) {
+	err := goerrors.New("boom")
+
+	st := grpcerr.GRPCStatus(err)
+
+	assert.Equal(t, codes.Unknown, st.Code())
+	assert.Equal(t, "boom", st.Message())
+}
+
+func TestWithCode_SetsCode(t *testing.T) {
+	err := grpcerr.WithCode(goerrors.New("not found"), codes.NotFound)
+
+	st := grpcerr.GRPCStatus(err)
+
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestWithCode_InnermostWins(t *testing.T) {
+	err := grpcerr.WithCode(goerrors.New("boom"), codes.NotFound)
+	err = grpcerr.WithCode(err, codes.Internal)
+
+	st := grpcerr.GRPCStatus(err)
+
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestWithCode_StatusFromError(t *testing.T) {
+	err := grpcerr.WithCode(goerrors.New("not found"), codes.NotFound)
+
+	st, ok := status.FromError(err)
+
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestWithCode_PreservesIs(t *testing.T) {
+	sentinel := goerrors.New("sentinel")
+
+	err := grpcerr.WithCode(errors.With(sentinel, "key", "value"), codes.NotFound)
+
+	assert.True(t, goerrors.Is(err, sentinel))
+}
+
+func TestGRPCStatus_FoldsContext(t *testing.T) {
+	err := errors.With(goerrors.New("boom"), "user_id", "42")
+	err = grpcerr.WithCode(err, codes.PermissionDenied)
+
+	st := grpcerr.GRPCStatus(err)
+
+	require.Len(t, st.Details(), 1)
+
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+
+	// Only the keyval the caller actually attached with With should make it
+	// into the wire-level metadata, not grpcerr's own bookkeeping keys.
+	assert.Equal(t, map[string]string{"user_id": "42"}, info.Metadata)
+}
+
+func TestGRPCStatus_DoesNotLeakCodeOrDetailsIntoMetadata(t *testing.T) {
+	err := grpcerr.WithCode(goerrors.New("boom"), codes.PermissionDenied)
+	err = grpcerr.WithGRPCDetails(err, &errdetails.ErrorInfo{Reason: "denied"})
+
+	st := grpcerr.GRPCStatus(err)
+
+	for _, d := range st.Details() {
+		info, ok := d.(*errdetails.ErrorInfo)
+		if !ok {
+			continue
+		}
+
+		assert.Empty(t, info.Metadata)
+	}
+}
@@ -0,0 +1,76 @@
+// Package handler provides structured error reporting on top of the errors
+// package, flattening a ContextualError's keyvals into the fields understood
+// by a given backend (stderr, slog, Sentry, ...) instead of losing them in
+// Error().
+package handler
+
+import (
+	"fmt"
+
+	"github.com/goph/stdlib/errors"
+)
+
+// Handler receives errors for reporting.
+type Handler interface {
+	Handle(err error)
+}
+
+// HandlerFunc adapts a plain function to the Handler interface.
+type HandlerFunc func(err error)
+
+// Handle calls f.
+func (f HandlerFunc) Handle(err error) {
+	f(err)
+}
+
+// Multi fans an error out to every underlying handler, in order.
+type Multi []Handler
+
+// Handle reports err to every handler in m.
+func (m Multi) Handle(err error) {
+	for _, h := range m {
+		h.Handle(err)
+	}
+}
+
+// DefaultHandler is used by the package-level Handle function. Replace it to
+// change where errors reported through Handle end up.
+var DefaultHandler Handler = NewStderr()
+
+// Handle reports err using DefaultHandler. It is a no-op if err is nil.
+func Handle(err error) {
+	if err == nil {
+		return
+	}
+
+	DefaultHandler.Handle(err)
+}
+
+// Recover is meant to be used with defer. It recovers from a panic, turns it
+// into an error with a captured stack trace, and reports it using h.
+// Non-error panic values are formatted with fmt.Errorf, the same
+// panic-to-error conversion ext.CloserFunc.Close already does for closers.
+func Recover(h Handler) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	err, ok := r.(error)
+	if !ok {
+		err = fmt.Errorf("%v", r)
+	}
+
+	h.Handle(errors.WithStack(err))
+}
+
+// contextOf returns the contextual keyvals attached to err, or nil if it
+// does not implement errors.ContextualError.
+func contextOf(err error) []interface{} {
+	ctxErr, ok := err.(errors.ContextualError)
+	if !ok {
+		return nil
+	}
+
+	return ctxErr.Context()
+}
@@ -0,0 +1,93 @@
+package handler_test
+
+import (
+	"bytes"
+	goerrors "errors"
+	"testing"
+
+	"github.com/goph/stdlib/errors"
+	"github.com/goph/stdlib/errors/handler"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandlerFunc_CallsUnderlyingFunc(t *testing.T) {
+	var handled error
+
+	h := handler.HandlerFunc(func(err error) {
+		handled = err
+	})
+
+	want := goerrors.New("boom")
+	h.Handle(want)
+
+	assert.Same(t, want, handled)
+}
+
+func TestMulti_FansOutToEveryHandler(t *testing.T) {
+	var calls int
+
+	h := handler.Multi{
+		handler.HandlerFunc(func(err error) { calls++ }),
+		handler.HandlerFunc(func(err error) { calls++ }),
+	}
+
+	h.Handle(goerrors.New("boom"))
+
+	assert.Equal(t, 2, calls)
+}
+
+func TestHandle_NilIsNoOp(t *testing.T) {
+	var calls int
+
+	old := handler.DefaultHandler
+	defer func() { handler.DefaultHandler = old }()
+
+	handler.DefaultHandler = handler.HandlerFunc(func(err error) { calls++ })
+
+	handler.Handle(nil)
+
+	assert.Equal(t, 0, calls)
+}
+
+func TestRecover_ConvertsErrorPanic(t *testing.T) {
+	var handled error
+
+	h := handler.HandlerFunc(func(err error) {
+		handled = err
+	})
+
+	func() {
+		defer handler.Recover(h)
+
+		panic(goerrors.New("boom"))
+	}()
+
+	assert.EqualError(t, handled, "boom")
+}
+
+func TestRecover_ConvertsNonErrorPanic(t *testing.T) {
+	var handled error
+
+	h := handler.HandlerFunc(func(err error) {
+		handled = err
+	})
+
+	func() {
+		defer handler.Recover(h)
+
+		panic("boom")
+	}()
+
+	assert.EqualError(t, handled, "boom")
+}
+
+func TestStderr_WritesError(t *testing.T) {
+	var buf bytes.Buffer
+
+	h := handler.Stderr{Writer: &buf}
+
+	h.Handle(errors.With(goerrors.New("boom"), "key", "value"))
+
+	assert.Contains(t, buf.String(), "boom")
+	assert.Contains(t, buf.String(), "key=value")
+}
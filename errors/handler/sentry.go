@@ -0,0 +1,47 @@
+package handler
+
+import "github.com/getsentry/sentry-go"
+
+// Sentry is a Handler that reports errors to Sentry, attaching contextual
+// keyvals as extra data on the captured event.
+type Sentry struct {
+	Hub *sentry.Hub
+}
+
+// NewSentry returns a Sentry handler using hub, or sentry.CurrentHub if hub
+// is nil.
+func NewSentry(hub *sentry.Hub) Sentry {
+	if hub == nil {
+		hub = sentry.CurrentHub()
+	}
+
+	return Sentry{Hub: hub}
+}
+
+// Handle captures err, attaching its contextual keyvals as extras on an
+// isolated scope so they don't leak onto unrelated events.
+func (h Sentry) Handle(err error) {
+	h.Hub.WithScope(func(scope *sentry.Scope) {
+		keyvals := contextOf(err)
+		if len(keyvals) > 0 {
+			scope.SetExtras(extrasOf(keyvals))
+		}
+
+		h.Hub.CaptureException(err)
+	})
+}
+
+func extrasOf(keyvals []interface{}) map[string]interface{} {
+	extras := make(map[string]interface{}, len(keyvals)/2)
+
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		key, ok := keyvals[i].(string)
+		if !ok {
+			continue
+		}
+
+		extras[key] = keyvals[i+1]
+	}
+
+	return extras
+}
@@ -0,0 +1,62 @@
+package handler_test
+
+import (
+	goerrors "errors"
+	"testing"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goph/stdlib/errors"
+	"github.com/goph/stdlib/errors/handler"
+)
+
+// capturingTransport records every event handed to it instead of sending it
+// anywhere, so tests can assert on what the SDK built.
+type capturingTransport struct {
+	events []*sentry.Event
+}
+
+func (t *capturingTransport) Configure(sentry.ClientOptions) {}
+func (t *capturingTransport) Flush(time.Duration) bool       { return true }
+func (t *capturingTransport) SendEvent(event *sentry.Event) {
+	t.events = append(t.events, event)
+}
+
+func newTestHub(t *testing.T, transport *capturingTransport) *sentry.Hub {
+	t.Helper()
+
+	client, err := sentry.NewClient(sentry.ClientOptions{Transport: transport})
+	require.NoError(t, err)
+
+	return sentry.NewHub(client, sentry.NewScope())
+}
+
+func TestSentry_FlattensContextIntoExtras(t *testing.T) {
+	transport := &capturingTransport{}
+	hub := newTestHub(t, transport)
+
+	handler.NewSentry(hub).Handle(errors.With(goerrors.New("boom"), "user_id", "42"))
+
+	require.Len(t, transport.events, 1)
+	assert.Equal(t, map[string]interface{}{"user_id": "42"}, transport.events[0].Extra)
+}
+
+func TestSentry_NoContext_NoExtras(t *testing.T) {
+	transport := &capturingTransport{}
+	hub := newTestHub(t, transport)
+
+	handler.NewSentry(hub).Handle(goerrors.New("boom"))
+
+	require.Len(t, transport.events, 1)
+	assert.Empty(t, transport.events[0].Extra)
+}
+
+func TestSentry_NilHub_UsesCurrentHub(t *testing.T) {
+	assert.NotPanics(t, func() {
+		handler.NewSentry(nil)
+	})
+	assert.Same(t, sentry.CurrentHub(), handler.NewSentry(nil).Hub)
+}
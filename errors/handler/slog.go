@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Slog is a Handler that reports errors through a *slog.Logger, flattening
+// contextual keyvals into structured attributes instead of burying them in
+// the error message.
+type Slog struct {
+	Logger *slog.Logger
+}
+
+// NewSlog returns a Slog handler using logger, or slog.Default if logger is
+// nil.
+func NewSlog(logger *slog.Logger) Slog {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return Slog{Logger: logger}
+}
+
+// Handle logs err at error level, with its contextual keyvals as attributes.
+func (h Slog) Handle(err error) {
+	attrs := []slog.Attr{slog.String("error", err.Error())}
+
+	keyvals := contextOf(err)
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		key, ok := keyvals[i].(string)
+		if !ok {
+			continue
+		}
+
+		attrs = append(attrs, slog.Any(key, keyvals[i+1]))
+	}
+
+	h.Logger.LogAttrs(context.Background(), slog.LevelError, "error", attrs...)
+}
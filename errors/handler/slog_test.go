@@ -0,0 +1,25 @@
+package handler_test
+
+import (
+	"bytes"
+	goerrors "errors"
+	"log/slog"
+	"testing"
+
+	"github.com/goph/stdlib/errors"
+	"github.com/goph/stdlib/errors/handler"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlog_FlattensContext(t *testing.T) {
+	var buf bytes.Buffer
+
+	h := handler.NewSlog(slog.New(slog.NewJSONHandler(&buf, nil)))
+
+	h.Handle(errors.With(goerrors.New("boom"), "user_id", "42"))
+
+	out := buf.String()
+
+	assert.Contains(t, out, `"error":"boom"`)
+	assert.Contains(t, out, `"user_id":"42"`)
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// Stderr is a Handler that writes errors, with a symbolized stack trace when
+// available, to an io.Writer (os.Stderr when built with NewStderr; the zero
+// value has a nil Writer).
+type Stderr struct {
+	Writer io.Writer
+}
+
+// NewStderr returns a Stderr handler writing to os.Stderr.
+func NewStderr() Stderr {
+	return Stderr{Writer: os.Stderr}
+}
+
+// Handle writes err to h.Writer using the "%+v" verb, so a stack-carrying
+// ContextualError prints its keyvals and frames.
+func (h Stderr) Handle(err error) {
+	fmt.Fprintf(h.Writer, "%+v\n", err)
+}
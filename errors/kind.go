@@ -0,0 +1,80 @@
+package errors
+
+import "errors"
+
+// Kind classifies an error into a broad category that callers can switch on
+// regardless of how deeply the underlying cause was wrapped by With or
+// WithPrefix.
+type Kind string
+
+// Predeclared kinds covering the classes most callers need to distinguish.
+const (
+	KindNotFound      Kind = "not_found"
+	KindAlreadyExists Kind = "already_exists"
+	KindPermission    Kind = "permission"
+	KindTimeout       Kind = "timeout"
+	KindTransient     Kind = "transient"
+	KindInternal      Kind = "internal"
+)
+
+// kindError attaches a Kind to an error.
+type kindError struct {
+	error
+	kind Kind
+}
+
+// WithKind attaches kind to err. It returns nil if err is nil.
+func WithKind(err error, kind Kind) error {
+	if err == nil {
+		return nil
+	}
+
+	return &kindError{error: err, kind: kind}
+}
+
+func (e *kindError) Unwrap() error { return e.error }
+
+// Kind returns the attached kind.
+func (e *kindError) Kind() Kind { return e.kind }
+
+// KindOf walks the wrap chain of err and returns the first attached Kind, or
+// the zero Kind if none is present. Callers can write
+// errors.KindOf(err) == errors.KindNotFound regardless of how deeply err was
+// wrapped by With/WithPrefix.
+func KindOf(err error) Kind {
+	var ke interface{ Kind() Kind }
+	if errors.As(err, &ke) {
+		return ke.Kind()
+	}
+
+	return ""
+}
+
+// temporary is implemented by errors that know whether they are safe to
+// retry, following the net.Error convention.
+type temporary interface {
+	Temporary() bool
+}
+
+// IsTemporary reports whether err is marked temporary, either via an
+// explicit KindTransient/KindTimeout or via a Temporary() bool method
+// anywhere in its chain.
+func IsTemporary(err error) bool {
+	switch KindOf(err) {
+	case KindTransient, KindTimeout:
+		return true
+	}
+
+	var t temporary
+	if errors.As(err, &t) {
+		return t.Temporary()
+	}
+
+	return false
+}
+
+// IsRetriable reports whether it is safe to retry the operation that
+// produced err. It is currently an alias for IsTemporary.
+func IsRetriable(err error) bool {
+	return IsTemporary(err)
+}
@@ -0,0 +1,53 @@
+package errors_test
+
+import (
+	goerrors "errors"
+	"testing"
+
+	"github.com/goph/stdlib/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKindOf_ThroughWrapChain(t *testing.T) {
+	err := errors.WithKind(goerrors.New("not found"), errors.KindNotFound)
+	err = errors.With(err, "key", "value")
+	err = errors.WithPrefix(err, "another", "value")
+
+	assert.Equal(t, errors.KindNotFound, errors.KindOf(err))
+}
+
+func TestKindOf_NoKindAttached(t *testing.T) {
+	assert.Equal(t, errors.Kind(""), errors.KindOf(goerrors.New("boom")))
+}
+
+func TestWithKind_Is(t *testing.T) {
+	sentinel := goerrors.New("sentinel")
+
+	err := errors.WithKind(sentinel, errors.KindTimeout)
+
+	assert.True(t, goerrors.Is(err, sentinel))
+}
+
+func TestIsTemporary_ExplicitKind(t *testing.T) {
+	assert.True(t, errors.IsTemporary(errors.WithKind(goerrors.New("boom"), errors.KindTransient)))
+	assert.True(t, errors.IsTemporary(errors.WithKind(goerrors.New("boom"), errors.KindTimeout)))
+	assert.False(t, errors.IsTemporary(errors.WithKind(goerrors.New("boom"), errors.KindPermission)))
+}
+
+type temporaryError struct {
+	temporary bool
+}
+
+func (e *temporaryError) Error() string   { return "temporary" }
+func (e *temporaryError) Temporary() bool { return e.temporary }
+
+func TestIsTemporary_NetErrorConvention(t *testing.T) {
+	assert.True(t, errors.IsTemporary(&temporaryError{temporary: true}))
+	assert.False(t, errors.IsTemporary(&temporaryError{temporary: false}))
+}
+
+func TestIsRetriable_MirrorsIsTemporary(t *testing.T) {
+	err := errors.WithKind(goerrors.New("boom"), errors.KindTransient)
+
+	assert.Equal(t, errors.IsTemporary(err), errors.IsRetriable(err))
+}
@@ -0,0 +1,126 @@
+package errors
+
+import "errors"
+
+// Op describes the operation that failed, following upspin's convention of
+// annotating errors with the function or method that produced them.
+type Op string
+
+// opError attaches an Op to an error.
+type opError struct {
+	error
+	op Op
+}
+
+// WithOp attaches op to err. It returns nil if err is nil.
+func WithOp(err error, op Op) error {
+	if err == nil {
+		return nil
+	}
+
+	return &opError{error: err, op: op}
+}
+
+func (e *opError) Unwrap() error { return e.error }
+
+// Op returns the attached Op.
+func (e *opError) Op() Op { return e.op }
+
+// OpOf walks the wrap chain of err and returns the first attached Op, or the
+// zero Op if none is present.
+func OpOf(err error) Op {
+	var oe interface{ Op() Op }
+	if errors.As(err, &oe) {
+		return oe.Op()
+	}
+
+	return ""
+}
+
+// Template describes the fields Match checks for in an error's chain. Zero
+// fields are ignored.
+type Template struct {
+	Kind Kind
+	Op   Op
+	// Key and Value describe a single keyval pair that must be present among
+	// the contextual keyvals attached with With/WithPrefix anywhere in the
+	// chain. Value is ignored if Key is empty; Value is ignored entirely
+	// (any value for Key matches) if Value is nil.
+	Key   string
+	Value interface{}
+}
+
+// Match reports whether every non-zero field of tmpl is present somewhere in
+// err's chain: a Kind via KindOf, an Op via OpOf, and a matching keyval pair
+// recorded via With or WithPrefix.
+func Match(tmpl Template, err error) bool {
+	if tmpl.Kind != "" && KindOf(err) != tmpl.Kind {
+		return false
+	}
+
+	if tmpl.Op != "" && OpOf(err) != tmpl.Op {
+		return false
+	}
+
+	if tmpl.Key != "" && !hasKeyval(err, tmpl.Key, tmpl.Value) {
+		return false
+	}
+
+	return true
+}
+
+// hasKeyval reports whether any ContextualError in err's chain carries key,
+// with the matching value if value is non-nil. It walks both single-error
+// Unwrap() error and multi-error Unwrap() []error chains (e.g. a
+// MultiError built with Combine), matching the traversal guarantees
+// errors.Is/errors.As already give the rest of this package.
+func hasKeyval(err error, key string, value interface{}) bool {
+	return walkChain(err, func(e error) bool {
+		ctxErr, ok := e.(ContextualError)
+		if !ok {
+			return false
+		}
+
+		kvs := ctxErr.Context()
+		for i := 0; i+1 < len(kvs); i += 2 {
+			k, ok := kvs[i].(string)
+			if !ok || k != key {
+				continue
+			}
+
+			if value == nil || kvs[i+1] == value {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+// walkChain visits every error reachable from err, following both
+// single-error Unwrap() error and multi-error Unwrap() []error (Go 1.20+)
+// chains, stopping as soon as visit returns true.
+func walkChain(err error, visit func(error) bool) bool {
+	for err != nil {
+		if visit(err) {
+			return true
+		}
+
+		switch x := err.(type) {
+		case interface{ Unwrap() error }:
+			err = x.Unwrap()
+		case interface{ Unwrap() []error }:
+			for _, child := range x.Unwrap() {
+				if walkChain(child, visit) {
+					return true
+				}
+			}
+
+			return false
+		default:
+			return false
+		}
+	}
+
+	return false
+}
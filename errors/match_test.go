@@ -0,0 +1,67 @@
+package errors_test
+
+import (
+	goerrors "errors"
+	"testing"
+
+	"github.com/goph/stdlib/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpOf_ThroughWrapChain(t *testing.T) {
+	err := errors.WithOp(goerrors.New("boom"), "Store.Get")
+	err = errors.With(err, "key", "value")
+
+	assert.Equal(t, errors.Op("Store.Get"), errors.OpOf(err))
+}
+
+func TestMatch_Kind(t *testing.T) {
+	err := errors.WithKind(goerrors.New("boom"), errors.KindNotFound)
+
+	assert.True(t, errors.Match(errors.Template{Kind: errors.KindNotFound}, err))
+	assert.False(t, errors.Match(errors.Template{Kind: errors.KindPermission}, err))
+}
+
+func TestMatch_Op(t *testing.T) {
+	err := errors.WithOp(goerrors.New("boom"), "Store.Get")
+
+	assert.True(t, errors.Match(errors.Template{Op: "Store.Get"}, err))
+	assert.False(t, errors.Match(errors.Template{Op: "Store.Put"}, err))
+}
+
+func TestMatch_Keyval(t *testing.T) {
+	err := errors.With(goerrors.New("boom"), "user_id", "42")
+
+	assert.True(t, errors.Match(errors.Template{Key: "user_id", Value: "42"}, err))
+	assert.True(t, errors.Match(errors.Template{Key: "user_id"}, err))
+	assert.False(t, errors.Match(errors.Template{Key: "user_id", Value: "7"}, err))
+	assert.False(t, errors.Match(errors.Template{Key: "missing"}, err))
+}
+
+func TestMatch_AllFields(t *testing.T) {
+	err := errors.With(goerrors.New("boom"), "user_id", "42")
+	err = errors.WithOp(err, "Store.Get")
+	err = errors.WithKind(err, errors.KindNotFound)
+
+	tmpl := errors.Template{
+		Kind:  errors.KindNotFound,
+		Op:    "Store.Get",
+		Key:   "user_id",
+		Value: "42",
+	}
+
+	assert.True(t, errors.Match(tmpl, err))
+
+	tmpl.Value = "7"
+	assert.False(t, errors.Match(tmpl, err))
+}
+
+func TestMatch_Keyval_InsideMultiErrorChild(t *testing.T) {
+	err := errors.Combine(
+		errors.With(errors.New("boom"), "user_id", "42"),
+		errors.New("other"),
+	)
+
+	assert.True(t, errors.Match(errors.Template{Key: "user_id", Value: "42"}, err))
+	assert.False(t, errors.Match(errors.Template{Key: "user_id", Value: "7"}, err))
+}
@@ -0,0 +1,61 @@
+package errors
+
+import "strings"
+
+// MultiError aggregates multiple errors into one, while keeping every child
+// reachable through Unwrap so errors.Is and errors.As can walk each of them.
+type MultiError interface {
+	error
+
+	// Errors returns the aggregated errors.
+	Errors() []error
+}
+
+// Combine aggregates errs into a single error, dropping any nil entries. It
+// returns nil if every error is nil, the single remaining error if only one
+// is non-nil, and a MultiError wrapping the rest otherwise.
+func Combine(errs ...error) error {
+	var filtered []error
+
+	for _, err := range errs {
+		if err != nil {
+			filtered = append(filtered, err)
+		}
+	}
+
+	switch len(filtered) {
+	case 0:
+		return nil
+	case 1:
+		return filtered[0]
+	default:
+		return &multiError{errs: filtered}
+	}
+}
+
+// multiError is the MultiError implementation returned by Combine.
+type multiError struct {
+	errs []error
+}
+
+// Error joins the message of every aggregated error with "; ".
+func (e *multiError) Error() string {
+	msgs := make([]string, len(e.errs))
+
+	for i, err := range e.errs {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// Errors returns the aggregated errors.
+func (e *multiError) Errors() []error {
+	return e.errs
+}
+
+// Unwrap returns the aggregated errors, letting errors.Is and errors.As (Go
+// 1.20+ multi-unwrap semantics) walk every child.
+func (e *multiError) Unwrap() []error {
+	return e.errs
+}
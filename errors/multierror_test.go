@@ -0,0 +1,59 @@
+package errors_test
+
+import (
+	goerrors "errors"
+	"testing"
+
+	"github.com/goph/stdlib/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCombine_AllNil(t *testing.T) {
+	assert.NoError(t, errors.Combine(nil, nil, nil))
+}
+
+func TestCombine_Empty(t *testing.T) {
+	assert.NoError(t, errors.Combine())
+}
+
+func TestCombine_Single(t *testing.T) {
+	err := goerrors.New("boom")
+
+	combined := errors.Combine(nil, err, nil)
+
+	assert.Same(t, err, combined)
+}
+
+func TestCombine_Multiple(t *testing.T) {
+	err1 := goerrors.New("first")
+	err2 := goerrors.New("second")
+
+	combined := errors.Combine(err1, nil, err2)
+
+	require.Error(t, combined)
+	assert.Equal(t, "first; second", combined.Error())
+
+	merr, ok := combined.(errors.MultiError)
+	require.True(t, ok)
+	assert.Equal(t, []error{err1, err2}, merr.Errors())
+}
+
+func TestCombine_Is(t *testing.T) {
+	sentinel := goerrors.New("sentinel")
+
+	combined := errors.Combine(goerrors.New("other"), sentinel)
+
+	assert.True(t, goerrors.Is(combined, sentinel))
+}
+
+func TestCombine_As(t *testing.T) {
+	original := &customError{msg: "custom"}
+
+	combined := errors.Combine(goerrors.New("other"), error(original))
+
+	var target *customError
+
+	assert.True(t, goerrors.As(combined, &target))
+	assert.Equal(t, original, target)
+}
@@ -0,0 +1,124 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+)
+
+// maxStackDepth bounds how many PCs New, Errorf, Wrap and WithStack capture.
+const maxStackDepth = 32
+
+// callers captures the PC stack of the caller of the exported function that
+// invokes it, skipping runtime.Callers, callers itself and that function.
+func callers() []uintptr {
+	var pcs [maxStackDepth]uintptr
+
+	n := runtime.Callers(3, pcs[:])
+
+	return pcs[:n]
+}
+
+// New returns an error with the given message and a captured stack trace.
+func New(message string) error {
+	return &contextualError{
+		err:   errors.New(message),
+		stack: callers(),
+	}
+}
+
+// Errorf formats according to a format specifier and returns the resulting
+// error with a captured stack trace.
+func Errorf(format string, args ...interface{}) error {
+	return &contextualError{
+		err:   fmt.Errorf(format, args...),
+		stack: callers(),
+	}
+}
+
+// Wrap returns an error annotating err with message and a captured stack
+// trace. It returns nil if err is nil.
+func Wrap(err error, message string) error {
+	if err == nil {
+		return nil
+	}
+
+	return &contextualError{
+		err:   fmt.Errorf("%s: %w", message, err),
+		stack: callers(),
+	}
+}
+
+// WithStack attaches a stack trace to err, unless err already carries one
+// anywhere in its chain, in which case err is returned unchanged. This keeps
+// repeated wrapping from duplicating frames.
+func WithStack(err error) error {
+	if err == nil || HasStack(err) {
+		return err
+	}
+
+	if c, ok := err.(*contextualError); ok {
+		wrapped := *c
+		wrapped.stack = callers()
+
+		return &wrapped
+	}
+
+	return &contextualError{
+		err:   err,
+		stack: callers(),
+	}
+}
+
+// stackTracer is implemented by errors that carry a captured PC stack.
+type stackTracer interface {
+	StackTrace() []uintptr
+}
+
+// HasStack reports whether err, or any error in its chain, already carries a
+// non-empty stack trace. Every *contextualError implements stackTracer
+// unconditionally (StackTrace just returns its possibly-nil stack field), so
+// errors.As alone would match contextual errors that never captured a
+// stack; walk the chain by hand and check the length instead.
+func HasStack(err error) bool {
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if st, ok := e.(stackTracer); ok && len(st.StackTrace()) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Format implements fmt.Formatter. "%+v" prints the error message, its
+// keyvals context and a symbolized stack trace, one frame per line. Every
+// other verb falls back to the plain error message.
+func (e *contextualError) Format(s fmt.State, verb rune) {
+	if verb != 'v' || !s.Flag('+') {
+		fmt.Fprint(s, e.Error())
+
+		return
+	}
+
+	fmt.Fprint(s, e.err.Error())
+
+	for i := 0; i+1 < len(e.keyvals); i += 2 {
+		fmt.Fprintf(s, "\n    %v=%v", e.keyvals[i], e.keyvals[i+1])
+	}
+
+	if len(e.stack) == 0 {
+		return
+	}
+
+	frames := runtime.CallersFrames(e.stack)
+
+	for {
+		frame, more := frames.Next()
+
+		fmt.Fprintf(s, "\n    %s\n        %s:%d", frame.Function, frame.File, frame.Line)
+
+		if !more {
+			break
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package errors_test
+
+import (
+	goerrors "errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/goph/stdlib/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stackTracer interface {
+	StackTrace() []uintptr
+}
+
+func TestNew_CapturesStack(t *testing.T) {
+	err := errors.New("boom")
+
+	st, ok := err.(stackTracer)
+
+	require.True(t, ok)
+	assert.NotEmpty(t, st.StackTrace())
+}
+
+func TestErrorf_CapturesStack(t *testing.T) {
+	err := errors.Errorf("boom: %d", 42)
+
+	assert.Equal(t, "boom: 42", err.Error())
+
+	st, ok := err.(stackTracer)
+
+	require.True(t, ok)
+	assert.NotEmpty(t, st.StackTrace())
+}
+
+func TestWrap_CapturesStackAndPreservesChain(t *testing.T) {
+	sentinel := goerrors.New("sentinel")
+
+	err := errors.Wrap(sentinel, "context")
+
+	assert.Equal(t, "context: sentinel", err.Error())
+	assert.True(t, goerrors.Is(err, sentinel))
+
+	st, ok := err.(stackTracer)
+
+	require.True(t, ok)
+	assert.NotEmpty(t, st.StackTrace())
+}
+
+func TestWrap_NilError(t *testing.T) {
+	assert.NoError(t, errors.Wrap(nil, "context"))
+}
+
+func TestWithStack_AttachesStackOnce(t *testing.T) {
+	sentinel := goerrors.New("sentinel")
+
+	err := errors.WithStack(sentinel)
+
+	st, ok := err.(stackTracer)
+	require.True(t, ok)
+	require.NotEmpty(t, st.StackTrace())
+
+	again := errors.WithStack(err)
+
+	assert.Equal(t, st.StackTrace(), again.(stackTracer).StackTrace())
+}
+
+func TestWithStack_PreservesKeyvals(t *testing.T) {
+	sentinel := goerrors.New("sentinel")
+
+	err := errors.With(sentinel, "key", "value")
+	err = errors.WithStack(err)
+
+	ctxErr, ok := err.(errors.ContextualError)
+	require.True(t, ok)
+	assert.Equal(t, []interface{}{"key", "value"}, ctxErr.Context())
+}
+
+func TestWithStack_AttachesFramesToContextualErrorWithNoStack(t *testing.T) {
+	sentinel := goerrors.New("sentinel")
+
+	// err is a *contextualError (via With) that never went through New,
+	// Errorf, Wrap or WithStack, so it carries no frames yet.
+	err := errors.With(sentinel, "key", "value")
+
+	wrapped := errors.WithStack(err)
+
+	st, ok := wrapped.(stackTracer)
+	require.True(t, ok)
+	assert.NotEmpty(t, st.StackTrace())
+}
+
+func TestContextualError_FormatPlusV(t *testing.T) {
+	err := errors.With(errors.New("boom"), "key", "value")
+
+	out := fmt.Sprintf("%+v", err)
+
+	assert.True(t, strings.HasPrefix(out, "boom\n    key=value"))
+	assert.Contains(t, out, "stack_test.go")
+}
+
+func TestContextualError_FormatV(t *testing.T) {
+	err := errors.With(goerrors.New("boom"), "key", "value")
+
+	assert.Equal(t, "boom", fmt.Sprintf("%v", err))
+}
@@ -0,0 +1,50 @@
+// Package ext provides small extensions to standard library interfaces.
+package ext
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/goph/stdlib/errors"
+)
+
+// CloserFunc adapts a plain function to the io.Closer interface. Any panic
+// raised by the function is recovered and turned into an error instead of
+// crashing the caller.
+type CloserFunc func()
+
+// Close calls the underlying function, recovering from any panic and
+// turning it into an error.
+func (f CloserFunc) Close() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+
+				return
+			}
+
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	f()
+
+	return nil
+}
+
+// Closers is a collection of closers that can be closed together.
+type Closers []io.Closer
+
+// Close closes every underlying closer, even if some of them return an
+// error, and aggregates every non-nil error into a single one using
+// errors.Combine.
+func (c Closers) Close() error {
+	var errs []error
+
+	for _, closer := range c {
+		errs = append(errs, closer.Close())
+	}
+
+	return errors.Combine(errs...)
+}
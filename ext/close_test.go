@@ -70,12 +70,13 @@ func TestClosers_Empty(t *testing.T) {
 }
 
 func TestClosers_Error(t *testing.T) {
+	err1 := fmt.Errorf("error one")
 	closer1 := &mocks.Closer{}
-	closer1.On("Close").Return(nil)
+	closer1.On("Close").Return(err1)
 
-	err := fmt.Errorf("error")
+	err2 := fmt.Errorf("error two")
 	closer2 := &mocks.Closer{}
-	closer2.On("Close").Return(err)
+	closer2.On("Close").Return(err2)
 
 	closer := ext.Closers{closer1, closer2}
 
@@ -87,7 +88,23 @@ func TestClosers_Error(t *testing.T) {
 
 	require.Error(t, merr)
 	require.Implements(t, (*errorCollection)(nil), merr)
-	assert.Contains(t, merr.(errorCollection).Errors(), err)
+	assert.Equal(t, []error{err1, err2}, merr.(errorCollection).Errors())
+
+	closer1.AssertExpectations(t)
+	closer2.AssertExpectations(t)
+}
+
+func TestClosers_SingleError(t *testing.T) {
+	closer1 := &mocks.Closer{}
+	closer1.On("Close").Return(nil)
+
+	err := fmt.Errorf("error")
+	closer2 := &mocks.Closer{}
+	closer2.On("Close").Return(err)
+
+	closer := ext.Closers{closer1, closer2}
+
+	assert.Same(t, err, closer.Close())
 
 	closer1.AssertExpectations(t)
 	closer2.AssertExpectations(t)